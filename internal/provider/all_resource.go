@@ -3,6 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/google/go-github/v53/github"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -11,10 +18,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+const allResourcePerPage = 100
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &allResource{}
-	_ resource.ResourceWithConfigure = &allResource{}
+	_ resource.Resource               = &allResource{}
+	_ resource.ResourceWithConfigure  = &allResource{}
+	_ resource.ResourceWithModifyPlan = &allResource{}
 )
 
 // NewAllResource is a helper function to simplify the provider implementation.
@@ -24,13 +34,20 @@ func NewAllResource() resource.Resource {
 
 // allResource is the resource implementation.
 type allResource struct {
-	owner  string
-	client *github.Client
+	owner       string
+	client      *github.Client
+	parallelism int
+	etagCache   *etagCache
 }
 
 // allResourceModel maps the resource schema data.
 type allResourceModel struct {
-	Repos map[string]allResourceRepoModel `tfsdk:"repos"`
+	IncludePatterns []types.String                 `tfsdk:"include_patterns"`
+	ExcludePatterns []types.String                 `tfsdk:"exclude_patterns"`
+	Visibility      types.String                   `tfsdk:"visibility"`
+	Type            types.String                   `tfsdk:"type"`
+	Archived        types.Bool                     `tfsdk:"archived"`
+	Repos           map[string]allResourceRepoModel `tfsdk:"repos"`
 }
 
 // allResourceRepoModel maps repo data.
@@ -38,40 +55,223 @@ type allResourceRepoModel struct {
 	ID types.Int64 `tfsdk:"id"`
 }
 
-func (r *allResource) readRepositories(ctx context.Context, stateRepos *map[string]allResourceRepoModel) error {
-	// Get refreshed repositories from GitHub
+// allResourceFilters is allResourceModel's filter attributes, converted to
+// plain Go values for matching against API results.
+type allResourceFilters struct {
+	includePatterns []string
+	excludePatterns []string
+	visibility      string
+	repoType        string
+	archived        types.Bool
+}
+
+func newAllResourceFilters(model allResourceModel) allResourceFilters {
+	f := allResourceFilters{
+		visibility: model.Visibility.ValueString(),
+		repoType:   model.Type.ValueString(),
+		archived:   model.Archived,
+	}
+	for _, p := range model.IncludePatterns {
+		f.includePatterns = append(f.includePatterns, p.ValueString())
+	}
+	for _, p := range model.ExcludePatterns {
+		f.excludePatterns = append(f.excludePatterns, p.ValueString())
+	}
+	return f
+}
+
+// effectiveRepoType returns the `type` value to forward to GitHub's list
+// org repos endpoint for filters. That endpoint has no separate `visibility`
+// or `archived` query parameters: `type` already accepts "public"/"private"
+// alongside its other values, so a visibility filter with no explicit `type`
+// set can be forwarded through it server-side. archived has no server-side
+// equivalent at all and is left to matches to filter client-side.
+func effectiveRepoType(filters allResourceFilters) string {
+	if filters.repoType != "" && filters.repoType != "all" {
+		return filters.repoType
+	}
+	if filters.visibility == "public" || filters.visibility == "private" {
+		return filters.visibility
+	}
+	return filters.repoType
+}
+
+// matches reports whether repo satisfies every configured filter.
+func (f allResourceFilters) matches(repo *github.Repository) bool {
+	if len(f.includePatterns) > 0 {
+		included := false
+		for _, pattern := range f.includePatterns {
+			if matched, _ := path.Match(pattern, repo.GetFullName()); matched {
+				included = true
+				break
+			}
+		}
+		if !included {
+			return false
+		}
+	}
+
+	for _, pattern := range f.excludePatterns {
+		if matched, _ := path.Match(pattern, repo.GetFullName()); matched {
+			return false
+		}
+	}
+
+	// Re-checked here even though effectiveRepoType already forwards this to
+	// the API when possible, since a `type` filter set independently of
+	// `visibility` (e.g. type = "forks") would otherwise bypass it.
+	switch f.visibility {
+	case "public":
+		if repo.GetPrivate() {
+			return false
+		}
+	case "private":
+		if !repo.GetPrivate() {
+			return false
+		}
+	}
+
+	// GET /orgs/{org}/repos has no `archived` query parameter, so this
+	// filter can only be applied client-side.
+	if !f.archived.IsNull() && repo.GetArchived() != f.archived.ValueBool() {
+		return false
+	}
+
+	return true
+}
+
+// readRepositories fetches every repository in r.owner matching filters.
+func (r *allResource) readRepositories(ctx context.Context, filters allResourceFilters) (map[string]allResourceRepoModel, error) {
 	tflog.Debug(ctx, "Reading GitHub repositories")
 
-	opt := &github.RepositoryListByOrgOptions{
-		Sort:        "full_name",
-		ListOptions: github.ListOptions{PerPage: 100},
+	repoType := effectiveRepoType(filters)
+
+	firstPage, lastPage, err := r.listOrgReposPage(ctx, 1, repoType)
+	if err != nil {
+		return nil, err
 	}
-	var allRepos []*github.Repository
-	for {
-		repos, gresp, err := r.client.Repositories.ListByOrg(ctx, r.owner, opt)
-		if err != nil {
-			return err
+
+	allRepos := firstPage
+
+	if lastPage > 1 {
+		pages := make([][]*github.Repository, lastPage+1)
+		pages[1] = firstPage
+
+		sem := make(chan struct{}, r.parallelism)
+		errs := make(chan error, lastPage-1)
+		var wg sync.WaitGroup
+
+		for page := 2; page <= lastPage; page++ {
+			wg.Add(1)
+			go func(page int) {
+				defer wg.Done()
+
+				sem <- struct{}{}
+				defer func() { <-sem }()
+
+				repos, _, err := r.listOrgReposPage(ctx, page, repoType)
+				if err != nil {
+					errs <- err
+					return
+				}
+				pages[page] = repos
+			}(page)
+		}
+
+		wg.Wait()
+		close(errs)
+		if err := <-errs; err != nil {
+			return nil, err
 		}
-		allRepos = append(allRepos, repos...)
-		if gresp.NextPage == 0 {
-			break
+
+		allRepos = nil
+		for page := 1; page <= lastPage; page++ {
+			allRepos = append(allRepos, pages[page]...)
 		}
-		opt.Page = gresp.NextPage
 	}
+
 	tflog.Debug(ctx, "GitHub repos are read", map[string]interface{}{"count": len(allRepos)})
 
-	tflog.Debug(ctx, "Setting repos to state")
+	repos := make(map[string]allResourceRepoModel)
 	for _, repo := range allRepos {
-		if _, ok := (*stateRepos)[*repo.Name]; !ok {
+		if !filters.matches(repo) {
 			continue
 		}
-		(*stateRepos)[*repo.Name] = allResourceRepoModel{
-			ID: types.Int64Value(*repo.ID),
+		repos[repo.GetName()] = allResourceRepoModel{
+			ID: types.Int64Value(repo.GetID()),
+		}
+	}
+
+	tflog.Debug(ctx, "Finished reading GitHub repositories", map[string]interface{}{"managed_count": len(repos)})
+	return repos, nil
+}
+
+// listOrgReposPage fetches a single page of r.owner's repositories through
+// r.client, issuing a conditional request against r.etagCache so pages
+// GitHub reports as unchanged (304 Not Modified) are served from cache
+// instead of re-fetched. repoType, if set, is forwarded to the API as the
+// `type` query parameter. It returns the page's repositories and the last
+// page number for the listing (0 if there is only one page).
+func (r *allResource) listOrgReposPage(ctx context.Context, page int, repoType string) ([]*github.Repository, int, error) {
+	opts := &github.RepositoryListByOrgOptions{
+		Type: repoType,
+		ListOptions: github.ListOptions{
+			Page:    page,
+			PerPage: allResourcePerPage,
+		},
+	}
+
+	u, err := url.Parse("orgs/" + url.PathEscape(r.owner) + "/repos")
+	if err != nil {
+		return nil, 0, err
+	}
+	u.RawQuery = reposListByOrgQuery(opts).Encode()
+
+	httpReq, err := r.client.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	cacheKey := u.String()
+	cached, hasCached := r.etagCache.get(cacheKey)
+	if hasCached {
+		httpReq.Header.Set("If-None-Match", cached.etag)
+	}
+
+	var repos []*github.Repository
+	ghResp, err := r.client.Do(ctx, httpReq, &repos)
+	if err != nil {
+		if ghResp != nil && ghResp.StatusCode == http.StatusNotModified && hasCached {
+			// The cache stores the last page number observed on the
+			// response that produced it, rather than re-parsing it off the
+			// 304 here: it's unclear whether GitHub echoes a Link header on
+			// a Not Modified response.
+			return cached.repos, cached.lastPage, nil
 		}
+		return nil, 0, err
+	}
+
+	if etag := ghResp.Header.Get("ETag"); etag != "" {
+		r.etagCache.set(cacheKey, cachedPage{etag: etag, repos: repos, lastPage: ghResp.LastPage})
 	}
 
-	tflog.Debug(ctx, "Finished reading GitHub repositories")
-	return nil
+	return repos, ghResp.LastPage, nil
+}
+
+// reposListByOrgQuery builds the query string for listing an org's
+// repositories with opts, so listOrgReposPage can issue the request by hand
+// through client.NewRequest/client.Do and attach conditional request
+// headers. It always sends sort=full_name, and omits type when it is empty
+// or "all".
+func reposListByOrgQuery(opts *github.RepositoryListByOrgOptions) url.Values {
+	values := url.Values{}
+	values.Set("sort", "full_name")
+	values.Set("per_page", strconv.Itoa(opts.PerPage))
+	values.Set("page", strconv.Itoa(opts.Page))
+	if opts.Type != "" && opts.Type != "all" {
+		values.Set("type", opts.Type)
+	}
+	return values
 }
 
 // Configure adds the provider configured client to the resource.
@@ -92,6 +292,8 @@ func (r *allResource) Configure(_ context.Context, req resource.ConfigureRequest
 
 	r.client = config.Client
 	r.owner = config.Owner
+	r.parallelism = config.Parallelism
+	r.etagCache = config.ETagCache
 }
 
 // Metadata returns the resource type name.
@@ -104,9 +306,31 @@ func (r *allResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 	resp.Schema = schema.Schema{
 		Description: descriptions["all_resource_schema"],
 		Attributes: map[string]schema.Attribute{
+			"include_patterns": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: descriptions["all_resource_include_patterns"],
+			},
+			"exclude_patterns": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: descriptions["all_resource_exclude_patterns"],
+			},
+			"visibility": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["all_resource_visibility"],
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["all_resource_type"],
+			},
+			"archived": schema.BoolAttribute{
+				Optional:    true,
+				Description: descriptions["all_resource_archived"],
+			},
 			"repos": schema.MapNestedAttribute{
 				Description: descriptions["repos"],
-				Required:    true,
+				Computed:    true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"id": schema.Int64Attribute{
@@ -120,18 +344,69 @@ func (r *allResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 	}
 }
 
+// ModifyPlan recomputes the set of repositories the filters would manage and
+// surfaces any additions or removals as a plan-time warning, so `terraform
+// plan` shows which repos are joining or leaving management instead of just
+// `repos = (known after apply)`.
+func (r *allResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		// Nothing to diff against on create, and nothing to show on destroy.
+		return
+	}
+
+	var state, plan allResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repos, err := r.readRepositories(ctx, newAllResourceFilters(plan))
+	if err != nil {
+		// Surfacing the error here would duplicate the one Read/Update
+		// already reports during apply; ModifyPlan just skips the preview.
+		return
+	}
+
+	var added, removed []string
+	for name := range repos {
+		if _, ok := state.Repos[name]; !ok {
+			added = append(added, name)
+		}
+	}
+	for name := range state.Repos {
+		if _, ok := repos[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+
+	if len(added) == 0 && len(removed) == 0 {
+		return
+	}
+
+	sort.Strings(added)
+	sort.Strings(removed)
+
+	resp.Diagnostics.AddWarning(
+		"Managed Repositories Will Change",
+		fmt.Sprintf("Applying this plan will add %d and remove %d repositories from management.\nAdded: %s\nRemoved: %s",
+			len(added), len(removed), strings.Join(added, ", "), strings.Join(removed, ", ")),
+	)
+
+	plan.Repos = repos
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *allResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	// Get current state
-	var state allResourceModel
-	diags := req.Plan.Get(ctx, &state)
+	var plan allResourceModel
+	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Overwrite items with refreshed state
-	err := r.readRepositories(ctx, &state.Repos)
+	repos, err := r.readRepositories(ctx, newAllResourceFilters(plan))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading GitHub Repositories",
@@ -139,18 +414,14 @@ func (r *allResource) Create(ctx context.Context, req resource.CreateRequest, re
 		)
 		return
 	}
+	plan.Repos = repos
 
-	// Set refreshed state
-	diags = resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (r *allResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	// Get current state
 	var state allResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -158,8 +429,7 @@ func (r *allResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
-	// Overwrite items with refreshed state
-	err := r.readRepositories(ctx, &state.Repos)
+	repos, err := r.readRepositories(ctx, newAllResourceFilters(state))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading GitHub Repositories",
@@ -167,27 +437,22 @@ func (r *allResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		)
 		return
 	}
+	state.Repos = repos
 
-	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *allResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get current state
-	var state allResourceModel
-	diags := req.Plan.Get(ctx, &state)
+	var plan allResourceModel
+	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Overwrite items with refreshed state
-	err := r.readRepositories(ctx, &state.Repos)
+	repos, err := r.readRepositories(ctx, newAllResourceFilters(plan))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading GitHub Repositories",
@@ -195,13 +460,10 @@ func (r *allResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		)
 		return
 	}
+	plan.Repos = repos
 
-	// Set refreshed state
-	diags = resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"sync"
+
+	"github.com/google/go-github/v53/github"
+)
+
+// cachedPage is a previously fetched repository listing page, keyed by the
+// request URL that produced it. lastPage is captured from the response that
+// populated the cache (go-github's parsed Link header) rather than
+// re-derived on a cache hit, since it's unclear whether GitHub echoes a Link
+// header on a 304 Not Modified response.
+type cachedPage struct {
+	etag     string
+	repos    []*github.Repository
+	lastPage int
+}
+
+// etagCache is a provider-scoped, concurrency-safe store of per-page ETags
+// so repeated repository listings can issue conditional requests and reuse
+// the previously parsed page on a 304 Not Modified response, instead of
+// re-fetching and re-parsing pages GitHub reports as unchanged.
+type etagCache struct {
+	mu      sync.Mutex
+	entries map[string]cachedPage
+}
+
+// newETagCache returns an empty etagCache.
+func newETagCache() *etagCache {
+	return &etagCache{entries: make(map[string]cachedPage)}
+}
+
+// get returns the cached page for key, if any.
+func (c *etagCache) get(key string) (cachedPage, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	return entry, ok
+}
+
+// set stores the page for key, replacing any previous entry.
+func (c *etagCache) set(key string, entry cachedPage) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = entry
+}
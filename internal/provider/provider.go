@@ -2,8 +2,10 @@ package provider
 
 import (
 	"context"
+	"crypto/tls"
 	"net/http"
 	"os"
+	"strings"
 
 	"github.com/google/go-github/v53/github"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -24,9 +26,115 @@ func init() {
 
 		"owner": "The GitHub owner name to manage. " +
 			"Use this field instead of `organization` when managing individual accounts.",
+
+		"base_url": "The base URL of the GitHub API to use. Defaults to the public GitHub API. " +
+			"Set this to the URL of a GitHub Enterprise Server instance, e.g. `https://github.example.com/api/v3/`, " +
+			"or use the `GITHUB_BASE_URL` environment variable. The value must end in a trailing slash.",
+
+		"insecure": "Whether to skip TLS certificate verification when talking to the GitHub API. " +
+			"Only useful against GitHub Enterprise Server instances with self-signed certificates. Defaults to `false`.",
+
+		"app_auth": "Configuration block for authenticating as a GitHub App installation instead of a personal " +
+			"access token. Conflicts with `token`.",
+
+		"app_auth_id": "The GitHub App ID. Can also be set via the `GITHUB_APP_ID` environment variable.",
+
+		"app_auth_installation_id": "The GitHub App installation ID. Can also be set via the " +
+			"`GITHUB_APP_INSTALLATION_ID` environment variable.",
+
+		"app_auth_pem_file": "The contents of the GitHub App's private key PEM file. Can also be set via the " +
+			"`GITHUB_APP_PEM_FILE` environment variable.",
+
+		"parallelism": "The maximum number of repository listing pages to fetch concurrently. Defaults to 5.",
+
+		"retry_max": "The maximum number of times to retry a request after a GitHub secondary rate limit " +
+			"(abuse detection) response. Defaults to 3.",
+
+		"repository_webhooks_data_source_schema": "Lists the webhooks configured on a single repository.",
+
+		"repository_webhooks_name": "The name of the repository to list webhooks for, within the provider's configured `owner`.",
+
+		"organization_webhooks_data_source_schema": "Lists the webhooks configured on the provider's configured `owner` organization.",
+
+		"webhooks_active_filter": "If set, only return webhooks whose `active` status matches this value.",
+
+		"webhooks": "The list of webhooks matching the filter criteria.",
+
+		"webhook_id": "The ID of the webhook.",
+
+		"webhook_type": "The kind of entity the webhook is attached to, e.g. `Repository` or `Organization`.",
+
+		"webhook_name": "The name of the webhook, e.g. `web` for a standard payload URL webhook.",
+
+		"webhook_url": "The payload URL the webhook delivers events to.",
+
+		"webhook_active": "Whether the webhook is active and will receive event deliveries.",
+
+		"webhook_events": "The list of events that trigger the webhook.",
+
+		"all_resource_include_patterns": "Glob patterns (matched against `owner/name`) of repositories to manage. " +
+			"If unset, every repository is included unless excluded by `exclude_patterns`.",
+
+		"all_resource_exclude_patterns": "Glob patterns (matched against `owner/name`) of repositories to exclude " +
+			"from management, applied after `include_patterns`.",
+
+		"all_resource_visibility": "Restrict managed repositories by visibility: `all` (default), `public`, or `private`.",
+
+		"all_resource_type": "Restrict managed repositories by relationship to `owner`, as accepted by the GitHub " +
+			"API: `all` (default), `owner`, `member`, `forks`, or `sources`.",
+
+		"all_resource_archived": "If set, restrict managed repositories to those whose `archived` status matches this value.",
+
+		"repository_resource_schema": "Creates and manages a single GitHub repository under the provider's configured `owner`.",
+
+		"repository_id": "The numeric ID of the repository.",
+
+		"repository_name": "The name of the repository. Changing this renames the repository rather than replacing it.",
+
+		"repository_description": "A short description of the repository.",
+
+		"repository_homepage_url": "A URL with more information about the repository.",
+
+		"repository_visibility": "The visibility of the repository: `public`, `private`, or `internal` (GitHub Enterprise only). Defaults to `public`.",
+
+		"repository_has_issues": "Whether to enable issues for the repository. Defaults to `true`.",
+
+		"repository_has_wiki": "Whether to enable the wiki for the repository. Defaults to `true`.",
+
+		"repository_has_projects": "Whether to enable projects for the repository. Defaults to `true`.",
+
+		"repository_allow_merge_commit": "Whether to allow merge commits for pull requests. Defaults to `true`.",
+
+		"repository_allow_squash_merge": "Whether to allow squash merging for pull requests. Defaults to `true`.",
+
+		"repository_allow_rebase_merge": "Whether to allow rebase merging for pull requests. Defaults to `true`.",
+
+		"repository_delete_branch_on_merge": "Whether to automatically delete head branches after pull requests are merged. Defaults to `false`.",
+
+		"repository_auto_init": "Whether to create an initial commit with a README when the repository is created. " +
+			"Only applies at creation time; changing this value after creation replaces the repository.",
+
+		"repository_gitignore_template": "The gitignore template to apply when the repository is created, e.g. `Go`. " +
+			"Only applies at creation time; changing this value after creation replaces the repository.",
+
+		"repository_license_template": "The license template to apply when the repository is created, e.g. `mit`. " +
+			"Only applies at creation time; changing this value after creation replaces the repository.",
+
+		"repository_topics": "The list of topics attached to the repository.",
+
+		"repository_archived": "Whether the repository is archived. Defaults to `false`.",
+
+		"repository_default_branch": "The name of the repository's default branch.",
 	}
 }
 
+const (
+	defaultParallelism = 5
+	defaultRetryMax    = 3
+)
+
+const defaultBaseURL = "https://api.github.com/"
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ provider.Provider = &githubreposProvider{}
@@ -51,8 +159,32 @@ type githubreposProvider struct {
 
 // githubreposProviderModel maps provider schema data to a Go type.
 type githubreposProviderModel struct {
-	Token types.String `tfsdk:"token"`
-	Owner types.String `tfsdk:"owner"`
+	Token       types.String                     `tfsdk:"token"`
+	Owner       types.String                     `tfsdk:"owner"`
+	BaseURL     types.String                     `tfsdk:"base_url"`
+	Insecure    types.Bool                       `tfsdk:"insecure"`
+	AppAuth     *githubreposProviderModelAppAuth `tfsdk:"app_auth"`
+	Parallelism types.Int64                      `tfsdk:"parallelism"`
+	RetryMax    types.Int64                      `tfsdk:"retry_max"`
+}
+
+// githubreposProviderModelAppAuth maps the nested app_auth block to a Go
+// type.
+type githubreposProviderModelAppAuth struct {
+	ID             types.String `tfsdk:"id"`
+	InstallationID types.String `tfsdk:"installation_id"`
+	PEMFile        types.String `tfsdk:"pem_file"`
+}
+
+// Config is the provider configuration made available to data sources and
+// resources via their Configure method.
+type Config struct {
+	Client      *github.Client
+	Owner       string
+	BaseURL     string
+	HTTPClient  *http.Client
+	Parallelism int
+	ETagCache   *etagCache
 }
 
 // Metadata returns the provider type name.
@@ -68,12 +200,49 @@ func (p *githubreposProvider) Schema(_ context.Context, _ provider.SchemaRequest
 		Attributes: map[string]schema.Attribute{
 			"token": schema.StringAttribute{
 				Optional:    true,
+				Sensitive:   true,
 				Description: descriptions["token"],
 			},
 			"owner": schema.StringAttribute{
 				Optional:    true,
 				Description: descriptions["owner"],
 			},
+			"base_url": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["base_url"],
+			},
+			"insecure": schema.BoolAttribute{
+				Optional:    true,
+				Description: descriptions["insecure"],
+			},
+			"parallelism": schema.Int64Attribute{
+				Optional:    true,
+				Description: descriptions["parallelism"],
+			},
+			"retry_max": schema.Int64Attribute{
+				Optional:    true,
+				Description: descriptions["retry_max"],
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"app_auth": schema.SingleNestedBlock{
+				Description: descriptions["app_auth"],
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Optional:    true,
+						Description: descriptions["app_auth_id"],
+					},
+					"installation_id": schema.StringAttribute{
+						Optional:    true,
+						Description: descriptions["app_auth_installation_id"],
+					},
+					"pem_file": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: descriptions["app_auth_pem_file"],
+					},
+				},
+			},
 		},
 	}
 }
@@ -109,6 +278,35 @@ func (p *githubreposProvider) Configure(ctx context.Context, req provider.Config
 		)
 	}
 
+	if config.BaseURL.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("base_url"),
+			"Unknown GitHub Base URL",
+			"The provider cannot create the GitHub API client as there is an unknown configuration value for the GitHub base URL. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the GITHUB_BASE_URL environment variable.",
+		)
+	}
+
+	if config.Insecure.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("insecure"),
+			"Unknown Insecure Setting",
+			"The provider cannot create the GitHub API client as there is an unknown configuration value for the insecure setting. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.AppAuth != nil {
+		if config.AppAuth.ID.IsUnknown() || config.AppAuth.InstallationID.IsUnknown() || config.AppAuth.PEMFile.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("app_auth"),
+				"Unknown GitHub App Authentication Configuration",
+				"The provider cannot create the GitHub API client as there is an unknown configuration value within the app_auth block. "+
+					"Either target apply the source of the value first or set the values statically in the configuration.",
+			)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -118,6 +316,13 @@ func (p *githubreposProvider) Configure(ctx context.Context, req provider.Config
 
 	token := os.Getenv("GITHUB_TOKEN")
 	owner := os.Getenv("GITHUB_OWNER")
+	baseURL := os.Getenv("GITHUB_BASE_URL")
+	if baseURL == "" {
+		baseURL = defaultBaseURL
+	}
+	insecure := false
+	parallelism := defaultParallelism
+	retryMax := defaultRetryMax
 
 	if !config.Token.IsNull() {
 		token = config.Token.ValueString()
@@ -127,19 +332,29 @@ func (p *githubreposProvider) Configure(ctx context.Context, req provider.Config
 		owner = config.Owner.ValueString()
 	}
 
-	// If any of the expected configurations are missing, return
-	// errors with provider-specific guidance.
+	if !config.BaseURL.IsNull() {
+		baseURL = config.BaseURL.ValueString()
+	}
 
-	if token == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("token"),
-			"Missing GitHub Token",
-			"The provider cannot create the GitHub API client as there is a missing or empty value for the GitHub token. "+
-				"Set the token value in the configuration or use the GITHUB_TOKEN environment variable. "+
-				"If either is already set, ensure the value is not empty.",
-		)
+	if !config.Insecure.IsNull() {
+		insecure = config.Insecure.ValueBool()
+	}
+
+	if !config.Parallelism.IsNull() {
+		parallelism = int(config.Parallelism.ValueInt64())
+	}
+
+	if !config.RetryMax.IsNull() {
+		retryMax = int(config.RetryMax.ValueInt64())
 	}
 
+	// If any of the expected configurations are missing, return
+	// errors with provider-specific guidance.
+	//
+	// Note that an empty token is not an error: it puts the client in
+	// anonymous mode, which is explicitly supported against both
+	// github.com and GitHub Enterprise Server.
+
 	if owner == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("owner"),
@@ -150,18 +365,96 @@ func (p *githubreposProvider) Configure(ctx context.Context, req provider.Config
 		)
 	}
 
+	if !strings.HasSuffix(baseURL, "/") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("base_url"),
+			"Invalid GitHub Base URL",
+			"The base_url value must end in a trailing slash, e.g. \"https://github.example.com/api/v3/\". Got: "+baseURL,
+		)
+	}
+
+	if parallelism < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("parallelism"),
+			"Invalid Parallelism",
+			"The parallelism value must be at least 1.",
+		)
+	}
+
+	if retryMax < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_max"),
+			"Invalid Retry Max",
+			"The retry_max value must not be negative.",
+		)
+	}
+
+	var appAuth *appAuthConfig
+	if config.AppAuth != nil {
+		if !config.Token.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("app_auth"),
+				"Conflicting Authentication Configuration",
+				"Only one of token or app_auth may be set, not both. "+
+					"Remove the token attribute or the app_auth block from the configuration.",
+			)
+		}
+
+		appID := os.Getenv("GITHUB_APP_ID")
+		installationID := os.Getenv("GITHUB_APP_INSTALLATION_ID")
+		pemFile := os.Getenv("GITHUB_APP_PEM_FILE")
+
+		if !config.AppAuth.ID.IsNull() {
+			appID = config.AppAuth.ID.ValueString()
+		}
+		if !config.AppAuth.InstallationID.IsNull() {
+			installationID = config.AppAuth.InstallationID.ValueString()
+		}
+		if !config.AppAuth.PEMFile.IsNull() {
+			pemFile = config.AppAuth.PEMFile.ValueString()
+		}
+
+		if appID == "" || installationID == "" || pemFile == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("app_auth"),
+				"Incomplete GitHub App Authentication Configuration",
+				"The app_auth block requires id, installation_id and pem_file to all be set, either in the "+
+					"configuration or via the GITHUB_APP_ID, GITHUB_APP_INSTALLATION_ID and GITHUB_APP_PEM_FILE "+
+					"environment variables.",
+			)
+		} else {
+			appAuth = &appAuthConfig{AppID: appID, InstallationID: installationID, PEMFile: pemFile}
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	ctx = tflog.SetField(ctx, "github_token", token)
 	ctx = tflog.SetField(ctx, "github_owner", owner)
+	ctx = tflog.SetField(ctx, "github_base_url", baseURL)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "github_token")
 
 	tflog.Debug(ctx, "Creating GitHub client")
 
+	baseTransport := &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: insecure},
+	}
+
+	var authTransport http.RoundTripper
+	if appAuth != nil {
+		authTransport = &appAuthTransport{cfg: *appAuth, baseURL: baseURL, base: baseTransport}
+	} else {
+		authTransport = &tokenTransport{token: token, base: baseTransport}
+	}
+
+	httpClient := &http.Client{
+		Transport: &rateLimitTransport{base: authTransport, retryMax: retryMax},
+	}
+
 	// Create a new GitHub client using the configuration values
-	client, err := github.NewEnterpriseClient("https://api.github.com/", "", &http.Client{})
+	client, err := github.NewEnterpriseClient(baseURL, baseURL, httpClient)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create GitHub API Client",
@@ -172,20 +465,51 @@ func (p *githubreposProvider) Configure(ctx context.Context, req provider.Config
 		return
 	}
 
+	providerConfig := &Config{
+		Client:      client,
+		Owner:       owner,
+		BaseURL:     baseURL,
+		HTTPClient:  httpClient,
+		Parallelism: parallelism,
+		ETagCache:   newETagCache(),
+	}
+
 	// Make the GitHub client available during DataSource and Resource
 	// type Configure methods.
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	resp.DataSourceData = providerConfig
+	resp.ResourceData = providerConfig
 
 	tflog.Info(ctx, "Configured GitHub client", map[string]any{"success": true})
 }
 
+// tokenTransport injects a bearer token into every outgoing request when one
+// is configured, leaving requests untouched (anonymous mode) otherwise.
+type tokenTransport struct {
+	token string
+	base  http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.token != "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("Authorization", "Bearer "+t.token)
+	}
+	return t.base.RoundTrip(req)
+}
+
 // DataSources defines the data sources implemented in the provider.
 func (p *githubreposProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return nil
+	return []func() datasource.DataSource{
+		NewRepositoryWebhooksDataSource,
+		NewOrganizationWebhooksDataSource,
+	}
 }
 
 // Resources defines the resources implemented in the provider.
 func (p *githubreposProvider) Resources(_ context.Context) []func() resource.Resource {
-	return nil
+	return []func() resource.Resource{
+		NewAllResource,
+		NewRepositoryResource,
+	}
 }
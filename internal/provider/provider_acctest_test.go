@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// testAccProtoV6ProviderFactories is shared by every acceptance test in this
+// package.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"githubrepos": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// testAccPreCheck verifies the environment variables required to run
+// acceptance tests against a real GitHub account are set, skipping the
+// caller's test otherwise.
+func testAccPreCheck(t *testing.T) {
+	if os.Getenv("GITHUB_TOKEN") == "" {
+		t.Skip("GITHUB_TOKEN must be set for acceptance tests")
+	}
+	if os.Getenv("GITHUB_OWNER") == "" {
+		t.Skip("GITHUB_OWNER must be set for acceptance tests")
+	}
+}
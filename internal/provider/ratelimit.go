@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// rateLimitTransport throttles outgoing requests to stay within GitHub's
+// primary rate limit and backs off on secondary rate limit responses. It
+// wraps an underlying transport (typically one that injects auth headers)
+// so the same request can be retried transparently.
+type rateLimitTransport struct {
+	base     http.RoundTripper
+	retryMax int
+
+	mu        sync.Mutex
+	remaining int
+	reset     time.Time
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.waitForPrimaryLimit(req)
+
+	var resp *http.Response
+	var err error
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.Body != nil {
+			// The previous attempt's body was already consumed (and may
+			// have been closed by the transport), so a retried request
+			// needs a fresh copy rather than replaying the same *Request.
+			if req.GetBody == nil {
+				return resp, err
+			}
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return resp, err
+			}
+			req.Body = body
+		}
+
+		resp, err = t.base.RoundTrip(req)
+		if err != nil {
+			return resp, err
+		}
+
+		t.recordPrimaryLimit(resp)
+
+		if !isSecondaryRateLimited(resp) || attempt >= t.retryMax {
+			return resp, err
+		}
+
+		wait := retryAfterDuration(resp)
+		resp.Body.Close()
+
+		select {
+		case <-time.After(wait):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+}
+
+// waitForPrimaryLimit blocks until the primary rate limit window resets if
+// the last observed response reported no remaining requests.
+func (t *rateLimitTransport) waitForPrimaryLimit(req *http.Request) {
+	t.mu.Lock()
+	remaining, reset := t.remaining, t.reset
+	t.mu.Unlock()
+
+	if remaining > 0 || reset.IsZero() {
+		return
+	}
+
+	wait := time.Until(reset)
+	if wait <= 0 {
+		return
+	}
+
+	select {
+	case <-time.After(wait):
+	case <-req.Context().Done():
+	}
+}
+
+// recordPrimaryLimit stores the primary rate limit window reported by the
+// most recent response, so the next request can wait for it if necessary.
+func (t *rateLimitTransport) recordPrimaryLimit(resp *http.Response) {
+	remaining, err := strconv.Atoi(resp.Header.Get("x-ratelimit-remaining"))
+	if err != nil {
+		return
+	}
+
+	resetUnix, err := strconv.ParseInt(resp.Header.Get("x-ratelimit-reset"), 10, 64)
+	if err != nil {
+		return
+	}
+
+	t.mu.Lock()
+	t.remaining = remaining
+	t.reset = time.Unix(resetUnix, 0)
+	t.mu.Unlock()
+}
+
+// isSecondaryRateLimited reports whether resp indicates GitHub's secondary
+// (abuse detection) rate limit, which is signalled via a Retry-After header
+// rather than the primary x-ratelimit-* headers.
+func isSecondaryRateLimited(resp *http.Response) bool {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return false
+	}
+	return resp.Header.Get("Retry-After") != ""
+}
+
+// retryAfterDuration parses the Retry-After header, defaulting to one
+// second if it is missing or malformed.
+func retryAfterDuration(resp *http.Response) time.Duration {
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds <= 0 {
+		return time.Second
+	}
+	return time.Duration(seconds) * time.Second
+}
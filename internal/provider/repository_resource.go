@@ -0,0 +1,483 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-github/v53/github"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &repositoryResource{}
+	_ resource.ResourceWithConfigure   = &repositoryResource{}
+	_ resource.ResourceWithImportState = &repositoryResource{}
+)
+
+// NewRepositoryResource is a helper function to simplify the provider
+// implementation.
+func NewRepositoryResource() resource.Resource {
+	return &repositoryResource{}
+}
+
+// repositoryResource is the resource implementation.
+type repositoryResource struct {
+	owner  string
+	client *github.Client
+}
+
+// repositoryResourceModel maps the resource schema data.
+type repositoryResourceModel struct {
+	ID                  types.Int64  `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	HomepageURL         types.String `tfsdk:"homepage_url"`
+	Visibility          types.String `tfsdk:"visibility"`
+	HasIssues           types.Bool   `tfsdk:"has_issues"`
+	HasWiki             types.Bool   `tfsdk:"has_wiki"`
+	HasProjects         types.Bool   `tfsdk:"has_projects"`
+	AllowMergeCommit    types.Bool   `tfsdk:"allow_merge_commit"`
+	AllowSquashMerge    types.Bool   `tfsdk:"allow_squash_merge"`
+	AllowRebaseMerge    types.Bool   `tfsdk:"allow_rebase_merge"`
+	DeleteBranchOnMerge types.Bool   `tfsdk:"delete_branch_on_merge"`
+	AutoInit            types.Bool   `tfsdk:"auto_init"`
+	GitignoreTemplate   types.String `tfsdk:"gitignore_template"`
+	LicenseTemplate     types.String `tfsdk:"license_template"`
+	Topics              types.List   `tfsdk:"topics"`
+	Archived            types.Bool   `tfsdk:"archived"`
+	DefaultBranch       types.String `tfsdk:"default_branch"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *repositoryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = config.Client
+	r.owner = config.Owner
+}
+
+// Metadata returns the resource type name.
+func (r *repositoryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository"
+}
+
+// Schema defines the schema for the resource.
+func (r *repositoryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: descriptions["repository_resource_schema"],
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:      true,
+				Description:   descriptions["repository_id"],
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: descriptions["repository_name"],
+			},
+			"description": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   descriptions["repository_description"],
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"homepage_url": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   descriptions["repository_homepage_url"],
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"visibility": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   descriptions["repository_visibility"],
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"has_issues": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   descriptions["repository_has_issues"],
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"has_wiki": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   descriptions["repository_has_wiki"],
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"has_projects": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   descriptions["repository_has_projects"],
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"allow_merge_commit": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   descriptions["repository_allow_merge_commit"],
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"allow_squash_merge": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   descriptions["repository_allow_squash_merge"],
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"allow_rebase_merge": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   descriptions["repository_allow_rebase_merge"],
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"delete_branch_on_merge": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   descriptions["repository_delete_branch_on_merge"],
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"auto_init": schema.BoolAttribute{
+				Optional:    true,
+				Description: descriptions["repository_auto_init"],
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"gitignore_template": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["repository_gitignore_template"],
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"license_template": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["repository_license_template"],
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"topics": schema.ListAttribute{
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   descriptions["repository_topics"],
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"archived": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   descriptions["repository_archived"],
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"default_branch": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   descriptions["repository_default_branch"],
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *repositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan repositoryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoReq, diags := repositoryFromModel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.AutoInit.IsNull() {
+		repoReq.AutoInit = github.Bool(plan.AutoInit.ValueBool())
+	}
+	if !plan.GitignoreTemplate.IsNull() {
+		repoReq.GitignoreTemplate = github.String(plan.GitignoreTemplate.ValueString())
+	}
+	if !plan.LicenseTemplate.IsNull() {
+		repoReq.LicenseTemplate = github.String(plan.LicenseTemplate.ValueString())
+	}
+
+	repo, _, err := r.client.Repositories.Create(ctx, r.owner, repoReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating GitHub Repository",
+			fmt.Sprintf("Could not create repository %s/%s: %s", r.owner, plan.Name.ValueString(), err),
+		)
+		return
+	}
+
+	// default_branch and archived aren't honored by the create endpoint, so
+	// apply them with a follow-up edit when requested.
+	editReq := &github.Repository{}
+	needsEdit := false
+
+	if !plan.DefaultBranch.IsNull() && plan.DefaultBranch.ValueString() != repo.GetDefaultBranch() {
+		editReq.DefaultBranch = github.String(plan.DefaultBranch.ValueString())
+		needsEdit = true
+	}
+	if !plan.Archived.IsNull() && plan.Archived.ValueBool() {
+		editReq.Archived = github.Bool(true)
+		needsEdit = true
+	}
+
+	if needsEdit {
+		repo, _, err = r.client.Repositories.Edit(ctx, r.owner, repo.GetName(), editReq)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Creating GitHub Repository",
+				fmt.Sprintf("Repository %s/%s was created, but could not be updated with its final default_branch/archived settings: %s", r.owner, repo.GetName(), err),
+			)
+			return
+		}
+	}
+
+	diags = refreshRepositoryModel(ctx, &plan, repo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *repositoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state repositoryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repo, httpResp, err := r.client.Repositories.Get(ctx, r.owner, state.Name.ValueString())
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading GitHub Repository",
+			fmt.Sprintf("Could not read repository %s/%s: %s", r.owner, state.Name.ValueString(), err),
+		)
+		return
+	}
+
+	diags = refreshRepositoryModel(ctx, &state, repo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *repositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state repositoryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoReq, diags := repositoryFromModel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Renaming is just another field on the edit request: the repo is
+	// addressed by its current (state) name and moved to the planned name.
+	repo, _, err := r.client.Repositories.Edit(ctx, r.owner, state.Name.ValueString(), repoReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating GitHub Repository",
+			fmt.Sprintf("Could not update repository %s/%s: %s", r.owner, state.Name.ValueString(), err),
+		)
+		return
+	}
+
+	diags = refreshRepositoryModel(ctx, &plan, repo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *repositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state repositoryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Repositories.Delete(ctx, r.owner, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting GitHub Repository",
+			fmt.Sprintf("Could not delete repository %s/%s: %s", r.owner, state.Name.ValueString(), err),
+		)
+	}
+}
+
+// ImportState imports a repository given its "owner/name" identifier. The
+// owner must match the provider's configured owner, since this resource
+// always manages repositories under that owner.
+func (r *repositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	owner, name, ok := strings.Cut(req.ID, "/")
+	if !ok || owner == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: owner/name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	if owner != r.owner {
+		resp.Diagnostics.AddError(
+			"Owner Mismatch",
+			fmt.Sprintf("The owner %q in the import identifier does not match the provider-configured owner %q.", owner, r.owner),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// repositoryFromModel builds a github.Repository suitable for the Create and
+// Edit APIs from the editable fields of model. auto_init, gitignore_template
+// and license_template are intentionally excluded, as they only apply at
+// creation time.
+func repositoryFromModel(ctx context.Context, model repositoryResourceModel) (*github.Repository, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	repo := &github.Repository{
+		Name: github.String(model.Name.ValueString()),
+	}
+
+	// Computed attributes are Unknown (not Null) on Create when the
+	// practitioner hasn't set them, so they can take their server-side
+	// default instead of an explicit zero value; only forward values the
+	// practitioner actually configured.
+	if !model.Description.IsNull() && !model.Description.IsUnknown() {
+		repo.Description = github.String(model.Description.ValueString())
+	}
+	if !model.HomepageURL.IsNull() && !model.HomepageURL.IsUnknown() {
+		repo.Homepage = github.String(model.HomepageURL.ValueString())
+	}
+	if !model.Visibility.IsNull() && !model.Visibility.IsUnknown() {
+		repo.Visibility = github.String(model.Visibility.ValueString())
+	}
+	if !model.HasIssues.IsNull() && !model.HasIssues.IsUnknown() {
+		repo.HasIssues = github.Bool(model.HasIssues.ValueBool())
+	}
+	if !model.HasWiki.IsNull() && !model.HasWiki.IsUnknown() {
+		repo.HasWiki = github.Bool(model.HasWiki.ValueBool())
+	}
+	if !model.HasProjects.IsNull() && !model.HasProjects.IsUnknown() {
+		repo.HasProjects = github.Bool(model.HasProjects.ValueBool())
+	}
+	if !model.AllowMergeCommit.IsNull() && !model.AllowMergeCommit.IsUnknown() {
+		repo.AllowMergeCommit = github.Bool(model.AllowMergeCommit.ValueBool())
+	}
+	if !model.AllowSquashMerge.IsNull() && !model.AllowSquashMerge.IsUnknown() {
+		repo.AllowSquashMerge = github.Bool(model.AllowSquashMerge.ValueBool())
+	}
+	if !model.AllowRebaseMerge.IsNull() && !model.AllowRebaseMerge.IsUnknown() {
+		repo.AllowRebaseMerge = github.Bool(model.AllowRebaseMerge.ValueBool())
+	}
+	if !model.DeleteBranchOnMerge.IsNull() && !model.DeleteBranchOnMerge.IsUnknown() {
+		repo.DeleteBranchOnMerge = github.Bool(model.DeleteBranchOnMerge.ValueBool())
+	}
+	if !model.Archived.IsNull() && !model.Archived.IsUnknown() {
+		repo.Archived = github.Bool(model.Archived.ValueBool())
+	}
+	if !model.DefaultBranch.IsNull() && !model.DefaultBranch.IsUnknown() {
+		repo.DefaultBranch = github.String(model.DefaultBranch.ValueString())
+	}
+	if !model.Topics.IsNull() && !model.Topics.IsUnknown() {
+		var topics []string
+		diags.Append(model.Topics.ElementsAs(ctx, &topics, false)...)
+		repo.Topics = topics
+	}
+
+	return repo, diags
+}
+
+// refreshRepositoryModel copies server-reported attributes from repo into
+// model. auto_init, gitignore_template and license_template are left as-is,
+// since the API never reports them back.
+func refreshRepositoryModel(ctx context.Context, model *repositoryResourceModel, repo *github.Repository) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.ID = types.Int64Value(repo.GetID())
+	model.Name = types.StringValue(repo.GetName())
+	model.Description = stringOrNull(repo.GetDescription())
+	model.HomepageURL = stringOrNull(repo.GetHomepage())
+	model.Visibility = types.StringValue(repo.GetVisibility())
+	model.HasIssues = types.BoolValue(repo.GetHasIssues())
+	model.HasWiki = types.BoolValue(repo.GetHasWiki())
+	model.HasProjects = types.BoolValue(repo.GetHasProjects())
+	model.AllowMergeCommit = types.BoolValue(repo.GetAllowMergeCommit())
+	model.AllowSquashMerge = types.BoolValue(repo.GetAllowSquashMerge())
+	model.AllowRebaseMerge = types.BoolValue(repo.GetAllowRebaseMerge())
+	model.DeleteBranchOnMerge = types.BoolValue(repo.GetDeleteBranchOnMerge())
+	model.Archived = types.BoolValue(repo.GetArchived())
+	model.DefaultBranch = types.StringValue(repo.GetDefaultBranch())
+
+	topics, topicsDiags := types.ListValueFrom(ctx, types.StringType, repo.Topics)
+	diags.Append(topicsDiags...)
+	model.Topics = topics
+
+	return diags
+}
+
+// stringOrNull returns a null types.String for an empty string, so unset
+// optional attributes like description and homepage_url round-trip as null
+// rather than as an empty string GitHub reports for "unset".
+func stringOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
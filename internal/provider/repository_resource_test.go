@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccRepositoryResource(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-repo-%d", os.Getpid())
+	rNameRenamed := rName + "-renamed"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRepositoryDestroy(rNameRenamed),
+		Steps: []resource.TestStep{
+			{
+				// Create, and check default_branch/archived as read back
+				// after creation. With main and false these match what
+				// GitHub creates, so no follow-up edit is made.
+				Config: testAccRepositoryResourceConfig(rName, "main", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("githubrepos_repository.test", "name", rName),
+					resource.TestCheckResourceAttr("githubrepos_repository.test", "default_branch", "main"),
+					resource.TestCheckResourceAttr("githubrepos_repository.test", "archived", "false"),
+					resource.TestCheckResourceAttrSet("githubrepos_repository.test", "id"),
+				),
+			},
+			{
+				// Rename via Update, and archive the repository.
+				Config: testAccRepositoryResourceConfig(rNameRenamed, "main", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("githubrepos_repository.test", "name", rNameRenamed),
+					resource.TestCheckResourceAttr("githubrepos_repository.test", "archived", "true"),
+				),
+			},
+			{
+				// Import by owner/name. auto_init, gitignore_template and
+				// license_template are create-only: the API never reports
+				// them back, so Read/ImportState can't repopulate them and
+				// they come back null post-import.
+				ResourceName:            "githubrepos_repository.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auto_init", "gitignore_template", "license_template"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["githubrepos_repository.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found in state: githubrepos_repository.test")
+					}
+					return fmt.Sprintf("%s/%s", os.Getenv("GITHUB_OWNER"), rs.Primary.Attributes["name"]), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccRepositoryResourceConfig(name, defaultBranch string, archived bool) string {
+	return fmt.Sprintf(`
+resource "githubrepos_repository" "test" {
+  name           = %[1]q
+  auto_init      = true
+  default_branch = %[2]q
+  archived       = %[3]t
+}
+`, name, defaultBranch, archived)
+}
+
+// testAccCheckRepositoryDestroy verifies name no longer exists under the
+// configured owner, confirming the resource's Delete actually removed it.
+func testAccCheckRepositoryDestroy(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		owner := os.Getenv("GITHUB_OWNER")
+		token := os.Getenv("GITHUB_TOKEN")
+
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("https://api.github.com/repos/%s/%s", owner, name), nil)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("repository %s/%s still exists after destroy (status %d)", owner, name, resp.StatusCode)
+		}
+
+		return nil
+	}
+}
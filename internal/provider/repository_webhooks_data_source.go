@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v53/github"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &repositoryWebhooksDataSource{}
+	_ datasource.DataSourceWithConfigure = &repositoryWebhooksDataSource{}
+)
+
+// NewRepositoryWebhooksDataSource is a helper function to simplify the
+// provider implementation.
+func NewRepositoryWebhooksDataSource() datasource.DataSource {
+	return &repositoryWebhooksDataSource{}
+}
+
+// repositoryWebhooksDataSource is the data source implementation.
+type repositoryWebhooksDataSource struct {
+	owner  string
+	client *github.Client
+}
+
+// repositoryWebhooksDataSourceModel maps the data source schema data.
+type repositoryWebhooksDataSourceModel struct {
+	Name     types.String   `tfsdk:"name"`
+	Active   types.Bool     `tfsdk:"active"`
+	Webhooks []webhookModel `tfsdk:"webhooks"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *repositoryWebhooksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = config.Client
+	d.owner = config.Owner
+}
+
+// Metadata returns the data source type name.
+func (d *repositoryWebhooksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_webhooks"
+}
+
+// Schema defines the schema for the data source.
+func (d *repositoryWebhooksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: descriptions["repository_webhooks_data_source_schema"],
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: descriptions["repository_webhooks_name"],
+			},
+			"active": schema.BoolAttribute{
+				Optional:    true,
+				Description: descriptions["webhooks_active_filter"],
+			},
+			"webhooks": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: descriptions["webhooks"],
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: webhookAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *repositoryWebhooksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state repositoryWebhooksDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hooks, err := listAllHooks(ctx, func(opt *github.ListOptions) ([]*github.Hook, *github.Response, error) {
+		return d.client.Repositories.ListHooks(ctx, d.owner, state.Name.ValueString(), opt)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading GitHub Repository Webhooks",
+			fmt.Sprintf("Could not list webhooks for repository %s/%s: %s", d.owner, state.Name.ValueString(), err),
+		)
+		return
+	}
+
+	webhooks, diags := flattenHooks(ctx, hooks, state.Active)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Webhooks = webhooks
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/google/go-github/v53/github"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// webhookModel maps a single webhook to a Go type, shared by the
+// repository and organization webhooks data sources.
+type webhookModel struct {
+	ID     types.Int64  `tfsdk:"id"`
+	Type   types.String `tfsdk:"type"`
+	Name   types.String `tfsdk:"name"`
+	URL    types.String `tfsdk:"url"`
+	Active types.Bool   `tfsdk:"active"`
+	Events types.List   `tfsdk:"events"`
+}
+
+// webhookAttributes returns the schema attributes for a single webhook,
+// shared by the repository and organization webhooks data sources.
+func webhookAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed:    true,
+			Description: descriptions["webhook_id"],
+		},
+		"type": schema.StringAttribute{
+			Computed:    true,
+			Description: descriptions["webhook_type"],
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: descriptions["webhook_name"],
+		},
+		"url": schema.StringAttribute{
+			Computed:    true,
+			Description: descriptions["webhook_url"],
+		},
+		"active": schema.BoolAttribute{
+			Computed:    true,
+			Description: descriptions["webhook_active"],
+		},
+		"events": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: descriptions["webhook_events"],
+		},
+	}
+}
+
+// listAllHooks pages through every result returned by list, which callers
+// supply bound to either the repository or organization ListHooks client
+// method.
+func listAllHooks(ctx context.Context, list func(*github.ListOptions) ([]*github.Hook, *github.Response, error)) ([]*github.Hook, error) {
+	opt := &github.ListOptions{PerPage: 100}
+
+	var allHooks []*github.Hook
+	for {
+		hooks, gresp, err := list(opt)
+		if err != nil {
+			return nil, err
+		}
+		allHooks = append(allHooks, hooks...)
+		if gresp.NextPage == 0 {
+			break
+		}
+		opt.Page = gresp.NextPage
+	}
+
+	return allHooks, nil
+}
+
+// flattenHooks converts GitHub API hooks into webhookModel values, skipping
+// any hook whose active status doesn't match activeFilter when it is set.
+func flattenHooks(ctx context.Context, hooks []*github.Hook, activeFilter types.Bool) ([]webhookModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	webhooks := make([]webhookModel, 0, len(hooks))
+	for _, hook := range hooks {
+		if !activeFilter.IsNull() && hook.GetActive() != activeFilter.ValueBool() {
+			continue
+		}
+
+		events, eventsDiags := types.ListValueFrom(ctx, types.StringType, hook.Events)
+		diags.Append(eventsDiags...)
+
+		webhooks = append(webhooks, webhookModel{
+			ID:     types.Int64Value(hook.GetID()),
+			Type:   types.StringValue(hook.GetType()),
+			Name:   types.StringValue(hook.GetName()),
+			URL:    types.StringValue(configURL(hook)),
+			Active: types.BoolValue(hook.GetActive()),
+			Events: events,
+		})
+	}
+
+	return webhooks, diags
+}
+
+// configURL extracts the webhook delivery URL from a hook's config map.
+func configURL(hook *github.Hook) string {
+	if hook.Config == nil {
+		return ""
+	}
+	url, _ := hook.Config["url"].(string)
+	return url
+}